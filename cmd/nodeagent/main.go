@@ -0,0 +1,77 @@
+// Command nodeagent runs as a DaemonSet on each node, detecting per-container
+// memcg OOM kills (including cgroup v2, which kubelet's node events never
+// cover) and forwarding them to the same database the central watcher reads.
+package main
+
+import (
+	"context"
+	"database/sql"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	_ "github.com/lib/pq"
+
+	"github.com/smpio/kube-oom-watcher/pkg/nodeagent"
+	"github.com/smpio/kube-oom-watcher/pkg/source"
+)
+
+func main() {
+	node := flag.String("node", "", "name of the node this agent runs on")
+	kmsgPath := flag.String("kmsg-path", "/dev/kmsg", "path to the kmsg device to tail")
+	dbURL := flag.String("db-url", "", "database URL")
+	useEBPF := flag.Bool("ebpf", false, "also attach an eBPF kprobe on oom_kill_process")
+	ebpfObjectPath := flag.String("ebpf-object", "", "path to the compiled oomkill.o (see pkg/nodeagent/bpf)")
+	flag.Parse()
+
+	if *node == "" {
+		if hostname, err := os.Hostname(); err == nil {
+			*node = hostname
+		}
+	}
+
+	if *node == "" {
+		log.Fatalln("Node name not set")
+	}
+
+	if *dbURL == "" {
+		log.Fatalln("Database URL not set")
+	}
+
+	db, err := sql.Open("postgres", *dbURL)
+	if err != nil {
+		log.Fatalln(err)
+	}
+	defer db.Close()
+
+	fwd, err := nodeagent.NewDBForwarder(db)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	sources := []source.Source{
+		&nodeagent.KmsgSource{Node: *node, Path: *kmsgPath},
+	}
+
+	if *useEBPF {
+		sources = append(sources, &nodeagent.EBPFSource{Node: *node, ObjectPath: *ebpfObjectPath})
+	}
+
+	errCh := make(chan error, len(sources))
+	for _, s := range sources {
+		go func(s source.Source) {
+			errCh <- nodeagent.Run(ctx, s, fwd)
+		}(s)
+	}
+
+	for range sources {
+		if err := <-errCh; err != nil && ctx.Err() == nil {
+			log.Fatalln(err)
+		}
+	}
+}
@@ -1,272 +1,349 @@
 package main
 
 import (
-	"bytes"
 	"context"
 	"database/sql"
-	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
-	"math/rand"
-	"net/http"
+	"os"
+	"os/signal"
 	"regexp"
-	"strconv"
+	"sync"
+	"syscall"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/leaderelection"
+	"k8s.io/client-go/tools/leaderelection/resourcelock"
+	"k8s.io/client-go/util/workqueue"
 
-	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/watch"
 
 	_ "github.com/lib/pq"
+
+	oomv1alpha1 "github.com/smpio/kube-oom-watcher/pkg/apis/oom/v1alpha1"
+	oomclientset "github.com/smpio/kube-oom-watcher/pkg/client/clientset/versioned"
+	"github.com/smpio/kube-oom-watcher/pkg/controller"
+	"github.com/smpio/kube-oom-watcher/pkg/metrics"
+	"github.com/smpio/kube-oom-watcher/pkg/notify"
+	"github.com/smpio/kube-oom-watcher/pkg/source"
+	"github.com/smpio/kube-oom-watcher/pkg/workload"
 )
 
+// leaseName is the name of the leader election Lease, when --leader-elect
+// is enabled.
+const leaseName = "kube-oom-watcher"
+
+// maxEventRetries is how many times a failed OOM event is retried before
+// it's given up on.
+const maxEventRetries = 5
+
 // PodInfo contains pod info
 type PodInfo struct {
 	Name      string
 	Namespace string
-}
 
-// OOMEvent comes from eventWatcher
-type OOMEvent struct {
-	Node  string
-	PID   uint64
-	Error error
+	// Containers maps a container's ID (as it appears in its cgroup path,
+	// without the runtime://  scheme prefix) to its name.
+	Containers map[string]string
+
+	// WorkloadKind and WorkloadName identify the pod's top-level owning
+	// workload, resolved per --owner-resolution.
+	WorkloadKind string
+	WorkloadName string
+
+	// Labels are the pod's own Kubernetes labels.
+	Labels map[string]string
 }
 
 var (
-	minWatchTimeout = 5 * time.Minute
-	uidIndex        *map[types.UID]PodInfo
-	pidRegExp       *regexp.Regexp
-	cgroupRegExp    *regexp.Regexp
-	webhookURL      string
-	db              *sql.DB
+	cgroupRegExp      *regexp.Regexp
+	containerIDRegExp = regexp.MustCompile(`[0-9a-f]{64}`)
+	ownerResolution   workload.Resolution
+	notifier          *notify.Manager
+	health            *metrics.Health
+	db                *sql.DB
+
+	podIndexMu sync.Mutex
+	podIndex   = map[types.UID]PodInfo{}
 )
 
 func main() {
 	masterURL := flag.String("master", "", "kubernetes api server url")
 	kubeconfigPath := flag.String("kubeconfig", "", "path to kubeconfig file")
 	dbURL := flag.String("db-url", "", "database URL")
-	flag.StringVar(&webhookURL, "webhook-url", "", "webhook URL")
+	configPath := flag.String("config", "", "path to notifier config YAML file")
+	webhookURL := flag.String("webhook-url", "", "generic JSON webhook URL")
+	slackWebhookURL := flag.String("slack-webhook-url", "", "Slack incoming webhook URL")
+	mattermostWebhookURL := flag.String("mattermost-webhook-url", "", "Mattermost incoming webhook URL")
+	teamsWebhookURL := flag.String("teams-webhook-url", "", "MS Teams incoming webhook URL")
+	pagerDutyRoutingKey := flag.String("pagerduty-routing-key", "", "PagerDuty Events API v2 routing key")
+	kubeEvent := flag.Bool("kube-event", false, "create a Kubernetes Event on the OOM-killed pod")
+	crd := flag.Bool("crd", false, "record an OOMEvent custom resource for every kill and reconcile OOMAlertPolicy objects against them")
+	notifyWindow := flag.Duration("notify-window", time.Minute, "suppress duplicate alerts for the same pod/container within this window")
+	ownerResolutionFlag := flag.String("owner-resolution", string(workload.Direct), "how far to walk a pod's owner chain to find its workload: none, direct, or full")
+	listenAddr := flag.String("listen-addr", ":8080", "address to serve /metrics, /healthz and /readyz on")
+	maxWatchFailures := flag.Int("max-watch-failures", 5, "watch reconnect failures before /healthz reports unhealthy; the count resets only when an informer completes its initial cache sync")
+	leaderElect := flag.Bool("leader-elect", false, "use leader election so only one replica is active at a time")
+	leaderElectNamespace := flag.String("leader-elect-namespace", "default", "namespace of the leader election lease")
 	flag.Parse()
 
+	ownerResolution = workload.Resolution(*ownerResolutionFlag)
+
+	health = metrics.NewHealth(*maxWatchFailures)
+	go func() {
+		log.Fatalln(metrics.ListenAndServe(*listenAddr, health))
+	}()
+
 	if *dbURL == "" {
 		log.Fatalln("Database URL not set")
 	}
 
-	if webhookURL == "" {
-		log.Fatalln("Webhook URL not set")
-	}
-
-	config, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfigPath)
+	kubeConfig, err := clientcmd.BuildConfigFromFlags(*masterURL, *kubeconfigPath)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	clientset, err := kubernetes.NewForConfig(config)
+	clientset, err := kubernetes.NewForConfig(kubeConfig)
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	db, err = sql.Open("postgres", *dbURL)
+	notifyConfig := &notify.Config{
+		Window:               *notifyWindow,
+		WebhookURL:           *webhookURL,
+		SlackWebhookURL:      *slackWebhookURL,
+		MattermostWebhookURL: *mattermostWebhookURL,
+		TeamsWebhookURL:      *teamsWebhookURL,
+		PagerDutyRoutingKey:  *pagerDutyRoutingKey,
+		KubeEvent:            *kubeEvent,
+		CRD:                  *crd,
+	}
+	if *configPath != "" {
+		notifyConfig, err = notify.LoadConfig(*configPath)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var oomClient *oomclientset.Clientset
+	if notifyConfig.CRD {
+		oomClient, err = oomclientset.NewForConfig(kubeConfig)
+		if err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	notifier, err = notifyConfig.BuildManager(clientset, oomClient)
 	if err != nil {
 		log.Fatalln(err)
 	}
-	defer db.Close()
 
-	pidRegExp, err = regexp.Compile("Kill\\s+process\\s+(\\d+)")
+	db, err = sql.Open("postgres", *dbURL)
 	if err != nil {
 		log.Fatalln(err)
 	}
+	defer db.Close()
 
 	cgroupRegExp, err = regexp.Compile("/pod([\\w\\-]+)/")
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	eventCh := make(chan OOMEvent, 128)
-
-	go podIndexer(clientset)
-	go eventWatcher(clientset, eventCh)
+	sources := []source.Source{
+		&source.APIServerSource{Clientset: clientset, Health: health},
+		&source.DBEventSource{DB: db},
+	}
 
-	for oomEvent := range eventCh {
-		var err error
-		if oomEvent.Error == nil {
-			err = handleOOM(oomEvent)
-			if err != nil {
-				err = handleError(err)
+	run := func(ctx context.Context) {
+		if notifyConfig.CRD {
+			// Started here, not unconditionally earlier: with --leader-elect
+			// every replica would otherwise run its own reconciliation loop
+			// and independently alert on every matching OOMEvent.
+			ctrl := controller.New(oomClient, 10*time.Minute)
+			ctrl.Notify = func(ctx context.Context, policy *oomv1alpha1.OOMAlertPolicy, event *oomv1alpha1.OOMEvent) error {
+				return notifier.NotifyNames(ctx, notify.OOMReport{
+					Namespace:    event.Namespace,
+					Pod:          event.Spec.Pod,
+					Container:    event.Spec.Container,
+					Node:         event.Spec.Node,
+					PID:          event.Spec.PID,
+					NSPID:        event.Spec.NSPID,
+					WorkloadKind: event.Spec.WorkloadKind,
+					WorkloadName: event.Spec.WorkloadName,
+					Labels:       event.Labels,
+					Time:         event.Spec.Timestamp.Time,
+				}, policy.Spec.Notifiers)
 			}
-		} else {
-			err = handleError(oomEvent.Error)
+			go ctrl.Run(ctx)
 		}
 
-		if err != nil {
-			log.Println(err)
-		}
-	}
-}
+		go podIndexer(ctx, clientset)
 
-func podIndexer(clientset *kubernetes.Clientset) {
-	for {
-		err := internalPodIndexer(clientset)
-		if statusErr, ok := err.(*apierrs.StatusError); ok {
-			if statusErr.ErrStatus.Reason == metav1.StatusReasonExpired {
-				log.Println("podIndexer:", err, "Restarting watch")
-				continue
-			}
+		eventCh := make(chan source.OOMEvent, 128)
+		for _, s := range sources {
+			go runSource(ctx, s, eventCh)
 		}
 
-		log.Fatalln(err)
+		processEvents(ctx, eventCh)
+	}
+
+	if !*leaderElect {
+		run(ctx)
+		return
 	}
-}
 
-func internalPodIndexer(clientset *kubernetes.Clientset) error {
-	list, err := clientset.CoreV1().Pods("").List(context.TODO(), metav1.ListOptions{})
+	id, err := os.Hostname()
 	if err != nil {
 		log.Fatalln(err)
 	}
 
-	index := make(map[types.UID]PodInfo, 1000)
-
-	for _, pod := range list.Items {
-		index[pod.UID] = PodInfo{
-			Name:      pod.Name,
-			Namespace: pod.Namespace,
-		}
+	lock := &resourcelock.LeaseLock{
+		LeaseMeta: metav1.ObjectMeta{
+			Name:      leaseName,
+			Namespace: *leaderElectNamespace,
+		},
+		Client: clientset.CoordinationV1(),
+		LockConfig: resourcelock.ResourceLockConfig{
+			Identity: id,
+		},
 	}
 
-	resourceVersion := list.ResourceVersion
-	uidIndex = &index
-
-	for {
-		log.Println("podIndexer: watching since", resourceVersion)
-
-		timeoutSeconds := int64(minWatchTimeout.Seconds() * (rand.Float64() + 1.0))
-		watcher, err := clientset.CoreV1().Pods("").Watch(context.TODO(), metav1.ListOptions{
-			ResourceVersion: resourceVersion,
-			TimeoutSeconds:  &timeoutSeconds,
-		})
-		if err != nil {
-			return err
-		}
-
-		for watchEvent := range watcher.ResultChan() {
-			if watchEvent.Type == watch.Error {
-				return apierrs.FromObject(watchEvent.Object)
-			}
-
-			pod, ok := watchEvent.Object.(*v1.Pod)
-			if !ok {
-				log.Println("podIndexer: unexpected kind:", watchEvent.Object.GetObjectKind().GroupVersionKind())
-				continue
-			}
-
-			resourceVersion = pod.ResourceVersion
-
-			if watchEvent.Type == watch.Added {
-				index[pod.UID] = PodInfo{
-					Name:      pod.Name,
-					Namespace: pod.Namespace,
-				}
-			} else if watchEvent.Type == watch.Deleted {
-				delete(index, pod.UID)
-			}
-		}
-	}
+	leaderelection.RunOrDie(ctx, leaderelection.LeaderElectionConfig{
+		Lock:            lock,
+		ReleaseOnCancel: true,
+		LeaseDuration:   15 * time.Second,
+		RenewDeadline:   10 * time.Second,
+		RetryPeriod:     2 * time.Second,
+		Callbacks: leaderelection.LeaderCallbacks{
+			OnStartedLeading: run,
+			OnStoppedLeading: func() {
+				log.Println("main: lost leadership, shutting down")
+			},
+		},
+	})
 }
 
-func eventWatcher(clientset *kubernetes.Clientset, c chan OOMEvent) {
-	for {
-		err := internalEventWatcher(clientset, c)
-		if statusErr, ok := err.(*apierrs.StatusError); ok {
-			if statusErr.ErrStatus.Reason == metav1.StatusReasonExpired {
-				log.Println("eventWatcher:", err, "Restarting watch")
-				continue
-			}
-		}
-
+func runSource(ctx context.Context, s source.Source, c chan<- source.OOMEvent) {
+	err := s.Run(ctx, c)
+	if err != nil && ctx.Err() == nil {
 		log.Fatalln(err)
 	}
 }
 
-func internalEventWatcher(clientset *kubernetes.Clientset, c chan OOMEvent) error {
-	list, err := clientset.CoreV1().Events("").List(context.TODO(), metav1.ListOptions{})
+// podIndexer keeps podIndex up to date from a Pod informer, so handleOOM
+// can resolve a cgroup's UID to a pod without hand-rolled resourceVersion
+// bookkeeping; the informer re-lists automatically on a 410 Gone and
+// resyncs periodically.
+func podIndexer(ctx context.Context, clientset *kubernetes.Clientset) {
+	factory := informers.NewSharedInformerFactory(clientset, 10*time.Minute)
+	informer := factory.Core().V1().Pods().Informer()
+
+	err := informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		log.Println("podIndexer:", err, "restarting watch")
+		metrics.EventWatchRestartsTotal.WithLabelValues("pod_indexer").Inc()
+		health.RecordWatchFailure()
+		cache.DefaultWatchErrorHandler(r, err)
+	})
 	if err != nil {
-		return err
+		log.Fatalln(err)
 	}
 
-	resourceVersion := list.ResourceVersion
-
-	for {
-		log.Println("eventWatcher: watching since", resourceVersion)
-
-		timeoutSeconds := int64(minWatchTimeout.Seconds() * (rand.Float64() + 1.0))
-		watcher, err := clientset.CoreV1().Events("").Watch(context.TODO(), metav1.ListOptions{
-			ResourceVersion: resourceVersion,
-			TimeoutSeconds:  &timeoutSeconds,
-		})
-		if err != nil {
-			return err
-		}
-
-		for watchEvent := range watcher.ResultChan() {
-			if watchEvent.Type == watch.Error {
-				return apierrs.FromObject(watchEvent.Object)
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				indexPod(clientset, pod)
 			}
-
-			event, ok := watchEvent.Object.(*v1.Event)
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			if pod, ok := obj.(*v1.Pod); ok {
+				indexPod(clientset, pod)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			pod, ok := obj.(*v1.Pod)
 			if !ok {
-				log.Println("eventWatcher: unexpected kind:", watchEvent.Object.GetObjectKind().GroupVersionKind())
-				continue
+				tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+				if !ok {
+					return
+				}
+				pod, ok = tombstone.Obj.(*v1.Pod)
+				if !ok {
+					return
+				}
 			}
+			unindexPod(pod.UID)
+		},
+	})
 
-			resourceVersion = event.ResourceVersion
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		if ctx.Err() != nil {
+			return
+		}
+		log.Fatalln("podIndexer: cache never synced")
+	}
 
-			if watchEvent.Type != watch.Added {
-				continue
-			}
+	health.SetReady(true)
+	health.RecordWatchSuccess()
 
-			if event.Reason != "OOMKilling" {
-				continue
-			}
+	<-ctx.Done()
+}
 
-			if event.InvolvedObject.Kind != "Node" {
-				continue
-			}
+func indexPod(clientset *kubernetes.Clientset, pod *v1.Pod) {
+	info := buildPodInfo(clientset, pod)
 
-			node := event.InvolvedObject.Name
-			pid, err := extractPID(event.Message)
-			if err != nil {
-				c <- OOMEvent{
-					Error: err,
-				}
-			}
+	podIndexMu.Lock()
+	podIndex[pod.UID] = info
+	metrics.PodIndexSize.Set(float64(len(podIndex)))
+	podIndexMu.Unlock()
+}
 
-			c <- OOMEvent{
-				Node: node,
-				PID:  pid,
-			}
-		}
-	}
+func unindexPod(uid types.UID) {
+	podIndexMu.Lock()
+	delete(podIndex, uid)
+	metrics.PodIndexSize.Set(float64(len(podIndex)))
+	podIndexMu.Unlock()
 }
 
-func extractPID(message string) (uint64, error) {
-	match := pidRegExp.FindStringSubmatch(message)
-	if match == nil {
-		return 0, fmt.Errorf("Event message does not match: %s", message)
+func lookupPod(uid types.UID) (PodInfo, bool) {
+	podIndexMu.Lock()
+	defer podIndexMu.Unlock()
+	info, ok := podIndex[uid]
+	return info, ok
+}
+
+func buildPodInfo(clientset *kubernetes.Clientset, pod *v1.Pod) PodInfo {
+	info := PodInfo{
+		Name:       pod.Name,
+		Namespace:  pod.Namespace,
+		Containers: make(map[string]string, len(pod.Status.ContainerStatuses)),
+		Labels:     pod.Labels,
 	}
 
-	pid, err := strconv.ParseUint(match[1], 10, 64)
+	for _, status := range pod.Status.ContainerStatuses {
+		if id := containerIDRegExp.FindString(status.ContainerID); id != "" {
+			info.Containers[id] = status.Name
+		}
+	}
+
+	kind, name, err := workload.Resolve(context.TODO(), clientset, pod.Namespace, pod.OwnerReferences, ownerResolution)
 	if err != nil {
-		return 0, err
+		log.Println("podIndexer: resolving owner of pod", pod.Namespace+"/"+pod.Name, err)
 	}
+	info.WorkloadKind = kind
+	info.WorkloadName = name
 
-	return pid, nil
+	return info
 }
 
 func extractUID(cgroup string) (types.UID, error) {
@@ -278,70 +355,132 @@ func extractUID(cgroup string) (types.UID, error) {
 	return types.UID(match[1]), nil
 }
 
-func handleOOM(event OOMEvent) error {
-	var cgroup string
-	var nspid uint64
+// processEvents drains eventCh into a rate-limiting workqueue and handles
+// events one at a time, retrying failures with backoff instead of letting
+// a single bad event wedge the whole pipeline. It blocks until ctx is
+// cancelled.
+func processEvents(ctx context.Context, eventCh <-chan source.OOMEvent) {
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+
+	go func() {
+		<-ctx.Done()
+		queue.ShutDown()
+	}()
+
+	go func() {
+		for oomEvent := range eventCh {
+			queue.Add(oomEvent)
+		}
+	}()
 
-	err := db.QueryRow(
-		`SELECT cgroup, nspid
-		FROM records
-		WHERE
-			hostname = $1 AND
-			pid = $2 AND
-			ts < current_timestamp
-		ORDER BY ts DESC
-		LIMIT 1`,
-		event.Node, event.PID).Scan(&cgroup, &nspid)
-
-	if err == sql.ErrNoRows {
-		return fmt.Errorf("No ps record for node %s and PID %d", event.Node, event.PID)
+	for processNextEvent(queue) {
 	}
+}
 
-	if err != nil {
-		return err
+func processNextEvent(queue workqueue.RateLimitingInterface) bool {
+	item, shutdown := queue.Get()
+	if shutdown {
+		return false
 	}
+	defer queue.Done(item)
 
-	uid, err := extractUID(cgroup)
-	if err != nil {
-		return err
+	oomEvent := item.(source.OOMEvent)
+
+	var err error
+	if oomEvent.Error == nil {
+		err = handleOOM(oomEvent)
+		if err != nil {
+			err = handleError(err)
+		}
+	} else {
+		err = handleError(oomEvent.Error)
 	}
 
-	if uidIndex == nil {
-		return fmt.Errorf("UID index not ready")
+	if err == nil {
+		queue.Forget(item)
+		return true
 	}
 
-	pod, ok := (*uidIndex)[uid]
-	if !ok {
-		return fmt.Errorf("Pod with UID %s is not known", uid)
+	if queue.NumRequeues(item) >= maxEventRetries {
+		log.Println("processEvents: giving up after", maxEventRetries, "retries:", err)
+		queue.Forget(item)
+		return true
 	}
 
-	return postMessage(map[string]string{
-		"username": "OOM watcher",
-		"text":     fmt.Sprintf("OOM in pod %s/%s (node: %s, PID: %d, NSPID: %d)", pod.Namespace, pod.Name, event.Node, event.PID, nspid),
-	})
+	log.Println("processEvents:", err, "- retrying")
+	queue.AddRateLimited(item)
+	return true
 }
 
-func handleError(err error) error {
-	return postMessage(map[string]string{
-		"username": "OOM watcher",
-		"text":     fmt.Sprint("Error: ", err),
-	})
-}
+func handleOOM(event source.OOMEvent) error {
+	cgroup := event.MemoryCgroup
+	var nspid uint64
 
-func postMessage(data interface{}) error {
-	jsonValue, err := json.Marshal(data)
-	if err != nil {
-		return err
+	if cgroup == "" {
+		// Sources that only know the node and PID (e.g. the API server
+		// source) rely on the ps-records table to resolve the cgroup.
+		queryStart := time.Now()
+		err := db.QueryRow(
+			`SELECT cgroup, nspid
+			FROM records
+			WHERE
+				hostname = $1 AND
+				pid = $2 AND
+				ts < current_timestamp
+			ORDER BY ts DESC
+			LIMIT 1`,
+			event.Node, event.PID).Scan(&cgroup, &nspid)
+		metrics.DBQueryDuration.Observe(time.Since(queryStart).Seconds())
+
+		if err == sql.ErrNoRows {
+			metrics.HandleErrorsTotal.WithLabelValues("no_ps_record").Inc()
+			return fmt.Errorf("No ps record for node %s and PID %d", event.Node, event.PID)
+		}
+
+		if err != nil {
+			metrics.HandleErrorsTotal.WithLabelValues("db_query").Inc()
+			return err
+		}
 	}
 
-	resp, err := http.Post(webhookURL, "application/json", bytes.NewBuffer(jsonValue))
+	uid, err := extractUID(cgroup)
 	if err != nil {
+		metrics.HandleErrorsTotal.WithLabelValues("extract_uid").Inc()
 		return err
 	}
 
-	if resp.StatusCode < 200 || resp.StatusCode > 299 {
-		return fmt.Errorf("Failed to POST: %s", resp.Status)
+	pod, ok := lookupPod(uid)
+	if !ok {
+		metrics.HandleErrorsTotal.WithLabelValues("unknown_pod").Inc()
+		return fmt.Errorf("Pod with UID %s is not known", uid)
 	}
 
-	return nil
+	container := event.Container
+	if container == "" {
+		if containerID := containerIDRegExp.FindString(cgroup); containerID != "" {
+			container = pod.Containers[containerID]
+		}
+	}
+
+	metrics.OOMEventsTotal.WithLabelValues(pod.Namespace, pod.Name, container, event.Node).Inc()
+
+	return notifier.Notify(context.Background(), notify.OOMReport{
+		Namespace:    pod.Namespace,
+		Pod:          pod.Name,
+		Container:    container,
+		Node:         event.Node,
+		PID:          event.PID,
+		NSPID:        nspid,
+		WorkloadKind: pod.WorkloadKind,
+		WorkloadName: pod.WorkloadName,
+		Labels:       pod.Labels,
+		Time:         time.Now(),
+	})
+}
+
+func handleError(err error) error {
+	return notifier.Notify(context.Background(), notify.OOMReport{
+		Time: time.Now(),
+		Err:  err,
+	})
 }
@@ -0,0 +1,95 @@
+// Package v1alpha1 is the v1alpha1 version of the oom.smpio.github.io API
+// group: OOMEvent (a per-incident history record) and OOMAlertPolicy
+// (namespace-scoped alerting rules for pods matching a label selector).
+// +k8s:deepcopy-gen=package
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OOMEvent is a per-incident record of a detected OOM kill. The watcher
+// creates one of these for every kill it observes, in addition to (or
+// instead of) posting to the configured notifier backends.
+type OOMEvent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OOMEventSpec `json:"spec"`
+}
+
+// OOMEventSpec describes a single OOM kill. The pod's top-level workload
+// is recorded by kind and name only, in WorkloadKind and WorkloadName; no
+// OwnerReferences are set on the surrounding ObjectMeta.
+type OOMEventSpec struct {
+	Pod          string      `json:"pod"`
+	Namespace    string      `json:"namespace"`
+	Container    string      `json:"container,omitempty"`
+	Node         string      `json:"node"`
+	PID          uint64      `json:"pid"`
+	NSPID        uint64      `json:"nspid,omitempty"`
+	RSS          uint64      `json:"rss,omitempty"`
+	WorkloadKind string      `json:"workloadKind,omitempty"`
+	WorkloadName string      `json:"workloadName,omitempty"`
+	Timestamp    metav1.Time `json:"timestamp"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OOMEventList is a list of OOMEvents.
+type OOMEventList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []OOMEvent `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OOMAlertPolicy configures how OOMEvents for a set of pods should be
+// turned into alerts: a cooldown between alerts, a "N kills in M minutes"
+// threshold, and which notifier destinations to fan out to.
+type OOMAlertPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec OOMAlertPolicySpec `json:"spec"`
+}
+
+// OOMAlertPolicySpec is the spec of an OOMAlertPolicy.
+type OOMAlertPolicySpec struct {
+	// Selector restricts this policy to pods carrying matching labels.
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Cooldown is the minimum time between two alerts for this policy.
+	Cooldown metav1.Duration `json:"cooldown,omitempty"`
+
+	// Threshold gates alerting on the event rate rather than firing on
+	// every single kill.
+	Threshold OOMAlertThreshold `json:"threshold,omitempty"`
+
+	// Notifiers lists the notifier backend names (as configured on the
+	// watcher, e.g. "slack", "pagerduty") this policy fans out to. Empty
+	// means all configured backends.
+	Notifiers []string `json:"notifiers,omitempty"`
+}
+
+// OOMAlertThreshold is an "N kills in M minutes" rate gate.
+type OOMAlertThreshold struct {
+	Count  int             `json:"count"`
+	Window metav1.Duration `json:"window"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OOMAlertPolicyList is a list of OOMAlertPolicies.
+type OOMAlertPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []OOMAlertPolicy `json:"items"`
+}
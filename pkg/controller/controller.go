@@ -0,0 +1,181 @@
+// Package controller reconciles OOMEvent custom resources against
+// OOMAlertPolicy objects: every newly observed OOMEvent is matched
+// against policies selecting its pod, gated on the policy's cooldown and
+// "N kills in M minutes" threshold, and handed to Notify.
+package controller
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+
+	oomv1alpha1 "github.com/smpio/kube-oom-watcher/pkg/apis/oom/v1alpha1"
+	clientset "github.com/smpio/kube-oom-watcher/pkg/client/clientset/versioned"
+)
+
+// Controller watches OOMEvents and OOMAlertPolicies and decides when an
+// event should actually trigger an alert.
+type Controller struct {
+	client clientset.Interface
+
+	eventInformer  cache.SharedIndexInformer
+	policyInformer cache.SharedIndexInformer
+
+	// Notify is called once a policy's threshold is crossed for event.
+	Notify func(ctx context.Context, policy *oomv1alpha1.OOMAlertPolicy, event *oomv1alpha1.OOMEvent) error
+
+	mu    sync.Mutex
+	state map[string]*policyState
+}
+
+type policyState struct {
+	windowStart time.Time
+	count       int
+	lastAlert   time.Time
+}
+
+// New builds a Controller backed by client, resyncing its informers every
+// resync.
+func New(client clientset.Interface, resync time.Duration) *Controller {
+	c := &Controller{
+		client: client,
+		state:  make(map[string]*policyState),
+	}
+
+	c.eventInformer = cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.OomV1alpha1().OOMEvents(metav1.NamespaceAll).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.OomV1alpha1().OOMEvents(metav1.NamespaceAll).Watch(context.TODO(), options)
+			},
+		},
+		&oomv1alpha1.OOMEvent{},
+		resync,
+		cache.Indexers{},
+	)
+
+	c.policyInformer = cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				return client.OomV1alpha1().OOMAlertPolicies(metav1.NamespaceAll).List(context.TODO(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				return client.OomV1alpha1().OOMAlertPolicies(metav1.NamespaceAll).Watch(context.TODO(), options)
+			},
+		},
+		&oomv1alpha1.OOMAlertPolicy{},
+		resync,
+		cache.Indexers{},
+	)
+
+	c.eventInformer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: c.handleOOMEvent,
+	})
+
+	return c
+}
+
+// Run starts the informers and blocks until ctx is cancelled.
+func (c *Controller) Run(ctx context.Context) {
+	go c.eventInformer.Run(ctx.Done())
+	go c.policyInformer.Run(ctx.Done())
+
+	cache.WaitForCacheSync(ctx.Done(), c.eventInformer.HasSynced, c.policyInformer.HasSynced)
+
+	<-ctx.Done()
+}
+
+func (c *Controller) handleOOMEvent(obj interface{}, isInInitialList bool) {
+	if isInInitialList {
+		// Don't replay already-existing OOMEvents: the CRD is explicitly
+		// meant to retain history for kubectl, and this fires on every
+		// watcher (re)start or leader re-election, so treating the initial
+		// list as "new" would re-alert for long-resolved kills.
+		return
+	}
+
+	event, ok := obj.(*oomv1alpha1.OOMEvent)
+	if !ok {
+		return
+	}
+
+	for _, p := range c.policyInformer.GetStore().List() {
+		policy, ok := p.(*oomv1alpha1.OOMAlertPolicy)
+		if !ok || policy.Namespace != event.Namespace {
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(policy.Spec.Selector)
+		if err != nil {
+			log.Println("controller: invalid selector on OOMAlertPolicy", policy.Name, err)
+			continue
+		}
+
+		if !selector.Matches(labels.Set(event.Labels)) {
+			continue
+		}
+
+		if !c.admit(policy) {
+			continue
+		}
+
+		if c.Notify == nil {
+			continue
+		}
+
+		if err := c.Notify(context.Background(), policy, event); err != nil {
+			log.Println("controller: notifying for policy", policy.Name, err)
+		}
+	}
+}
+
+// admit applies policy's cooldown and threshold, reporting whether this
+// kill should actually result in an alert being sent.
+func (c *Controller) admit(policy *oomv1alpha1.OOMAlertPolicy) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := policy.Namespace + "/" + policy.Name
+	s, ok := c.state[key]
+	if !ok {
+		s = &policyState{}
+		c.state[key] = s
+	}
+
+	now := time.Now()
+
+	if policy.Spec.Cooldown.Duration > 0 && !s.lastAlert.IsZero() && now.Sub(s.lastAlert) < policy.Spec.Cooldown.Duration {
+		return false
+	}
+
+	window := policy.Spec.Threshold.Window.Duration
+	threshold := policy.Spec.Threshold.Count
+	if threshold <= 0 {
+		threshold = 1
+	}
+
+	if window > 0 && now.Sub(s.windowStart) > window {
+		s.windowStart = now
+		s.count = 0
+	}
+
+	s.count++
+
+	if s.count < threshold {
+		return false
+	}
+
+	s.count = 0
+	s.windowStart = now
+	s.lastAlert = now
+	return true
+}
@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oomv1alpha1 "github.com/smpio/kube-oom-watcher/pkg/apis/oom/v1alpha1"
+)
+
+func TestAdmitThreshold(t *testing.T) {
+	c := New(nil, time.Minute)
+
+	policy := &oomv1alpha1.OOMAlertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: oomv1alpha1.OOMAlertPolicySpec{
+			Threshold: oomv1alpha1.OOMAlertThreshold{
+				Count:  3,
+				Window: metav1.Duration{Duration: time.Minute},
+			},
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		if c.admit(policy) {
+			t.Fatalf("admit() = true before threshold reached (kill %d)", i+1)
+		}
+	}
+
+	if !c.admit(policy) {
+		t.Fatal("admit() = false on the kill that crosses the threshold")
+	}
+
+	if c.admit(policy) {
+		t.Fatal("admit() = true again right after firing, before a new window's worth of kills")
+	}
+}
+
+func TestAdmitCooldown(t *testing.T) {
+	c := New(nil, time.Minute)
+
+	policy := &oomv1alpha1.OOMAlertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+		Spec: oomv1alpha1.OOMAlertPolicySpec{
+			Cooldown: metav1.Duration{Duration: time.Hour},
+		},
+	}
+
+	if !c.admit(policy) {
+		t.Fatal("admit() = false on the first kill, with no prior alert")
+	}
+
+	if c.admit(policy) {
+		t.Fatal("admit() = true within the cooldown of the previous alert")
+	}
+}
+
+func TestAdmitDefaultsThresholdToOne(t *testing.T) {
+	c := New(nil, time.Minute)
+
+	policy := &oomv1alpha1.OOMAlertPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "test"},
+	}
+
+	if !c.admit(policy) {
+		t.Fatal("admit() = false for a policy with no threshold configured, want every kill to admit")
+	}
+}
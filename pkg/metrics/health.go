@@ -0,0 +1,57 @@
+package metrics
+
+import "sync"
+
+// Health tracks the watcher's readiness and liveness for the /readyz and
+// /healthz endpoints.
+type Health struct {
+	maxWatchFailures int
+
+	mu                  sync.Mutex
+	ready               bool
+	consecutiveFailures int
+}
+
+// NewHealth builds a Health tracker. The watcher is reported unhealthy
+// once RecordWatchFailure has been called maxWatchFailures times in a row
+// without an intervening RecordWatchSuccess.
+func NewHealth(maxWatchFailures int) *Health {
+	return &Health{maxWatchFailures: maxWatchFailures}
+}
+
+// SetReady marks the watcher ready (or not). Call with true once the
+// initial pod list completes and the UID index is populated.
+func (h *Health) SetReady(ready bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.ready = ready
+}
+
+// Ready reports whether the watcher is ready to serve.
+func (h *Health) Ready() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.ready
+}
+
+// RecordWatchFailure records a watch reconnect failure.
+func (h *Health) RecordWatchFailure() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.consecutiveFailures++
+}
+
+// RecordWatchSuccess resets the consecutive-failure counter.
+func (h *Health) RecordWatchSuccess() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.consecutiveFailures = 0
+}
+
+// Healthy reports whether the watcher has not exceeded its consecutive
+// watch-failure budget.
+func (h *Health) Healthy() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.maxWatchFailures <= 0 || h.consecutiveFailures < h.maxWatchFailures
+}
@@ -0,0 +1,64 @@
+// Package metrics registers the watcher's Prometheus metrics and serves
+// them alongside /healthz and /readyz, making the watcher observable the
+// same way modern Kubernetes controllers are.
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// OOMEventsTotal counts OOM kills the watcher has resolved to a known
+	// pod. It is incremented before notifiers are called, so it also counts
+	// kills whose notification failed.
+	OOMEventsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oom_events_total",
+		Help: "Total number of detected OOM kills.",
+	}, []string{"namespace", "pod", "container", "node"})
+
+	// HandleErrorsTotal counts failures to resolve an OOM event to a pod,
+	// broken down by the step that failed. Notifier failures are not
+	// counted here.
+	HandleErrorsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "oom_handle_errors_total",
+		Help: "Total number of errors encountered while handling OOM events.",
+	}, []string{"reason"})
+
+	// PodIndexSize is the number of pods currently tracked by the pod
+	// indexer's UID index.
+	PodIndexSize = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "pod_index_size",
+		Help: "Number of pods currently tracked by the pod indexer.",
+	})
+
+	// EventWatchRestartsTotal counts how often a watch had to be
+	// re-established, per source.
+	EventWatchRestartsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "event_watch_restarts_total",
+		Help: "Total number of times a watch had to be restarted.",
+	}, []string{"source"})
+
+	// DBQueryDuration measures how long the ps-records lookup takes.
+	DBQueryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name: "db_query_duration_seconds",
+		Help: "Duration of database queries.",
+	})
+
+	// NotifierSendDuration measures how long each notifier backend takes
+	// to deliver a report.
+	NotifierSendDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "notifier_send_duration_seconds",
+		Help: "Duration of notifier send attempts.",
+	}, []string{"backend", "status"})
+)
+
+func init() {
+	prometheus.MustRegister(
+		OOMEventsTotal,
+		HandleErrorsTotal,
+		PodIndexSize,
+		EventWatchRestartsTotal,
+		DBQueryDuration,
+		NotifierSendDuration,
+	)
+}
@@ -0,0 +1,21 @@
+//go:build !linux
+
+package nodeagent
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/smpio/kube-oom-watcher/pkg/source"
+)
+
+// EBPFSource is only supported on Linux.
+type EBPFSource struct {
+	Node       string
+	ObjectPath string
+}
+
+// Run implements source.Source.
+func (s *EBPFSource) Run(ctx context.Context, c chan<- source.OOMEvent) error {
+	return fmt.Errorf("eBPF source is not supported on this platform")
+}
@@ -0,0 +1,85 @@
+package nodeagent
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log"
+
+	"github.com/smpio/kube-oom-watcher/pkg/source"
+)
+
+// DBForwarder writes OOMEvents straight into the same `records`-adjacent
+// Postgres database the central watcher already reads from, so the
+// node-agent can run without needing a gRPC endpoint for simple
+// deployments. It creates the `node_agent_events` table on first use.
+type DBForwarder struct {
+	DB *sql.DB
+}
+
+// NewDBForwarder prepares a DBForwarder, creating its table if needed.
+func NewDBForwarder(db *sql.DB) (*DBForwarder, error) {
+	_, err := db.Exec(`
+		CREATE TABLE IF NOT EXISTS node_agent_events (
+			id             SERIAL PRIMARY KEY,
+			ts             TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+			node           TEXT NOT NULL,
+			pid            BIGINT NOT NULL,
+			container      TEXT,
+			memory_cgroup  TEXT,
+			rss            BIGINT,
+			oom_score_adj  INTEGER
+		)`)
+	if err != nil {
+		return nil, fmt.Errorf("creating node_agent_events table: %w", err)
+	}
+
+	return &DBForwarder{DB: db}, nil
+}
+
+// Forward implements Forwarder.
+func (f *DBForwarder) Forward(ctx context.Context, event source.OOMEvent) error {
+	_, err := f.DB.ExecContext(ctx, `
+		INSERT INTO node_agent_events (node, pid, container, memory_cgroup, rss, oom_score_adj)
+		VALUES ($1, $2, $3, $4, $5, $6)`,
+		event.Node, event.PID, event.Container, event.MemoryCgroup, event.RSS, event.OOMScoreAdj)
+	return err
+}
+
+// Forwarder ships OOMEvents detected on a node to wherever the central
+// watcher reads them from. DBForwarder is the default; a gRPC-based
+// forwarder can be added here following the same interface once the
+// watcher exposes an ingest API.
+type Forwarder interface {
+	Forward(ctx context.Context, event source.OOMEvent) error
+}
+
+// Run reads OOMEvents produced by src and ships each one to fwd, logging
+// and continuing past forwarding errors so a single bad event (or a
+// momentary DB blip) doesn't take the agent down.
+func Run(ctx context.Context, src source.Source, fwd Forwarder) error {
+	c := make(chan source.OOMEvent, 128)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- src.Run(ctx, c)
+	}()
+
+	for {
+		select {
+		case event := <-c:
+			if event.Error != nil {
+				log.Println("nodeagent:", event.Error)
+				continue
+			}
+
+			if err := fwd.Forward(ctx, event); err != nil {
+				log.Println("nodeagent: forwarding event:", err)
+			}
+		case err := <-errCh:
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,128 @@
+// Package nodeagent implements a DaemonSet-mode companion to the watcher
+// that detects per-container memcg OOM kills directly on the node, which
+// kubelet never surfaces as "OOMKilling" node events on cgroup v2 clusters.
+package nodeagent
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+
+	"github.com/smpio/kube-oom-watcher/pkg/source"
+)
+
+var (
+	memcgOOMRegExp   = regexp.MustCompile(`Memory cgroup out of memory: Killed process (\d+) .*?,\s*cgroup=(\S+)`)
+	constraintRegExp = regexp.MustCompile(`oom-kill:constraint=\S+.*?,task=\S+,pid=(\d+),.*?,oom_score_adj=(-?\d+)`)
+	rssRegExp        = regexp.MustCompile(`anon-rss:(\d+)kB`)
+)
+
+// KmsgSource tails /dev/kmsg and parses the kernel's memcg OOM killer log
+// lines into OOMEvents. It implements source.Source.
+type KmsgSource struct {
+	// Node is the name of the node this agent is running on, used to
+	// populate OOMEvent.Node so events line up with the node-agnostic
+	// APIServerSource.
+	Node string
+
+	// Path is the kmsg device to tail. Defaults to /dev/kmsg.
+	Path string
+}
+
+// Run implements source.Source.
+func (s *KmsgSource) Run(ctx context.Context, c chan<- source.OOMEvent) error {
+	path := s.Path
+	if path == "" {
+		path = "/dev/kmsg"
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", path, err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	// kmsg lines can be long when they include a full oom_score_adj dump;
+	// grow the buffer well past bufio's 64KiB default.
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		event, ok := parseKmsgLine(scanner.Text())
+		if !ok {
+			continue
+		}
+
+		event.Node = s.Node
+		c <- event
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	return fmt.Errorf("%s closed", path)
+}
+
+// parseKmsgLine parses a single kmsg line into an OOMEvent. ok is false
+// for lines that aren't an OOM kill. Node is left zero-valued; the caller
+// fills it in.
+func parseKmsgLine(line string) (event source.OOMEvent, ok bool) {
+	if match := memcgOOMRegExp.FindStringSubmatch(line); match != nil {
+		pid, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return source.OOMEvent{Error: fmt.Errorf("parsing kmsg OOM line: %w", err)}, true
+		}
+
+		return source.OOMEvent{
+			PID:          pid,
+			MemoryCgroup: match[2],
+			RSS:          parseRSS(line),
+		}, true
+	}
+
+	if match := constraintRegExp.FindStringSubmatch(line); match != nil {
+		pid, err := strconv.ParseUint(match[1], 10, 64)
+		if err != nil {
+			return source.OOMEvent{Error: fmt.Errorf("parsing kmsg oom-kill line: %w", err)}, true
+		}
+
+		oomScoreAdj, err := strconv.Atoi(match[2])
+		if err != nil {
+			oomScoreAdj = 0
+		}
+
+		return source.OOMEvent{
+			PID:         pid,
+			OOMScoreAdj: oomScoreAdj,
+			RSS:         parseRSS(line),
+		}, true
+	}
+
+	return source.OOMEvent{}, false
+}
+
+// parseRSS extracts the anon-rss value from line, in bytes. It returns 0
+// if line doesn't contain one.
+func parseRSS(line string) uint64 {
+	match := rssRegExp.FindStringSubmatch(line)
+	if match == nil {
+		return 0
+	}
+
+	rss, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return rss * 1024
+}
@@ -0,0 +1,70 @@
+package nodeagent
+
+import "testing"
+
+func TestParseKmsgLine(t *testing.T) {
+	cases := []struct {
+		name        string
+		line        string
+		wantOK      bool
+		wantPID     uint64
+		wantCgroup  string
+		wantRSS     uint64
+		wantScoreOK bool
+		wantScore   int
+	}{
+		{
+			name:       "memcg oom kill",
+			line:       `Memory cgroup out of memory: Killed process 12345 (python) total-vm:102400kB, anon-rss:51200kB, file-rss:256kB, shmem-rss:0kB, cgroup=/kubepods/burstable/pod123/abcdef`,
+			wantOK:     true,
+			wantPID:    12345,
+			wantCgroup: "/kubepods/burstable/pod123/abcdef",
+			wantRSS:    51200 * 1024,
+		},
+		{
+			name:        "cgroup v2 constraint summary",
+			line:        `oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=/,mems_allowed=0,oom_memcg=/kubepods,task_memcg=/kubepods,task=python,pid=12345,uid=0,oom_score_adj=999`,
+			wantOK:      true,
+			wantPID:     12345,
+			wantScoreOK: true,
+			wantScore:   999,
+		},
+		{
+			name:   "unrelated line",
+			line:   `kernel: eth0: link up`,
+			wantOK: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			event, ok := parseKmsgLine(c.line)
+			if ok != c.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, c.wantOK)
+			}
+			if !ok {
+				return
+			}
+
+			if event.Error != nil {
+				t.Fatalf("unexpected error: %v", event.Error)
+			}
+
+			if event.PID != c.wantPID {
+				t.Errorf("PID = %d, want %d", event.PID, c.wantPID)
+			}
+
+			if c.wantCgroup != "" && event.MemoryCgroup != c.wantCgroup {
+				t.Errorf("MemoryCgroup = %q, want %q", event.MemoryCgroup, c.wantCgroup)
+			}
+
+			if c.wantRSS != 0 && event.RSS != c.wantRSS {
+				t.Errorf("RSS = %d, want %d", event.RSS, c.wantRSS)
+			}
+
+			if c.wantScoreOK && event.OOMScoreAdj != c.wantScore {
+				t.Errorf("OOMScoreAdj = %d, want %d", event.OOMScoreAdj, c.wantScore)
+			}
+		})
+	}
+}
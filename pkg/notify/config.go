@@ -0,0 +1,86 @@
+package notify
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"gopkg.in/yaml.v3"
+	"k8s.io/client-go/kubernetes"
+
+	oomclientset "github.com/smpio/kube-oom-watcher/pkg/client/clientset/versioned"
+)
+
+// Config describes which notifier backends to fan alerts out to. It can be
+// populated from a YAML file (--config) or built up field-by-field from
+// individual command-line flags. Environment variables are not read.
+type Config struct {
+	Window time.Duration `yaml:"window"`
+
+	WebhookURL           string `yaml:"webhookURL"`
+	SlackWebhookURL      string `yaml:"slackWebhookURL"`
+	MattermostWebhookURL string `yaml:"mattermostWebhookURL"`
+	TeamsWebhookURL      string `yaml:"teamsWebhookURL"`
+	PagerDutyRoutingKey  string `yaml:"pagerDutyRoutingKey"`
+	KubeEvent            bool   `yaml:"kubeEvent"`
+
+	// CRD enables recording an OOMEvent custom resource for every report,
+	// in addition to whichever other backends are configured above.
+	CRD bool `yaml:"crd"`
+}
+
+// LoadConfig reads and parses a notify Config from a YAML file.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// BuildManager constructs the Notifier backends described by config and
+// wraps them in a Manager. clientset is only needed when config.KubeEvent
+// is set, and oomClient only when config.CRD is set; pass nil otherwise.
+func (config *Config) BuildManager(clientset *kubernetes.Clientset, oomClient oomclientset.Interface) (*Manager, error) {
+	var notifiers []Notifier
+
+	if config.WebhookURL != "" {
+		notifiers = append(notifiers, &Webhook{URL: config.WebhookURL})
+	}
+	if config.SlackWebhookURL != "" {
+		notifiers = append(notifiers, &Slack{WebhookURL: config.SlackWebhookURL})
+	}
+	if config.MattermostWebhookURL != "" {
+		notifiers = append(notifiers, &Mattermost{WebhookURL: config.MattermostWebhookURL})
+	}
+	if config.TeamsWebhookURL != "" {
+		notifiers = append(notifiers, &Teams{WebhookURL: config.TeamsWebhookURL})
+	}
+	if config.PagerDutyRoutingKey != "" {
+		notifiers = append(notifiers, &PagerDuty{RoutingKey: config.PagerDutyRoutingKey})
+	}
+	if config.KubeEvent {
+		if clientset == nil {
+			return nil, fmt.Errorf("kubeEvent notifier requires a Kubernetes clientset")
+		}
+		notifiers = append(notifiers, &KubeEvent{Clientset: clientset})
+	}
+	if config.CRD {
+		if oomClient == nil {
+			return nil, fmt.Errorf("crd notifier requires an OOM CRD clientset")
+		}
+		notifiers = append(notifiers, &CRDSink{Client: oomClient})
+	}
+
+	if len(notifiers) == 0 {
+		return nil, fmt.Errorf("no notifier backends configured")
+	}
+
+	return NewManager(notifiers, config.Window), nil
+}
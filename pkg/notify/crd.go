@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oomv1alpha1 "github.com/smpio/kube-oom-watcher/pkg/apis/oom/v1alpha1"
+	clientset "github.com/smpio/kube-oom-watcher/pkg/client/clientset/versioned"
+)
+
+// CRDSink records an OOMEvent custom resource for every report instead of
+// (or in addition to) posting to a chat/paging backend, giving operators
+// queryable history via `kubectl get oomevents -A` and a feed for
+// pkg/controller's policy-based alerting.
+type CRDSink struct {
+	Client clientset.Interface
+}
+
+// Name implements Notifier.
+func (s *CRDSink) Name() string { return "crd" }
+
+// Notify implements Notifier. It is a no-op for error reports, since those
+// don't describe an OOM kill to record.
+func (s *CRDSink) Notify(ctx context.Context, report OOMReport) error {
+	if report.Err != nil {
+		return nil
+	}
+
+	labels := make(map[string]string, len(report.Labels)+1)
+	for k, v := range report.Labels {
+		labels[k] = v
+	}
+	labels["oom.smpio.github.io/pod"] = report.Pod
+
+	event := &oomv1alpha1.OOMEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "oom-",
+			Namespace:    report.Namespace,
+			Labels:       labels,
+		},
+		Spec: oomv1alpha1.OOMEventSpec{
+			Pod:          report.Pod,
+			Namespace:    report.Namespace,
+			Container:    report.Container,
+			Node:         report.Node,
+			PID:          report.PID,
+			NSPID:        report.NSPID,
+			WorkloadKind: report.WorkloadKind,
+			WorkloadName: report.WorkloadName,
+			Timestamp:    metav1.NewTime(report.Time),
+		},
+	}
+
+	_, err := s.Client.OomV1alpha1().OOMEvents(report.Namespace).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
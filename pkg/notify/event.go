@@ -0,0 +1,60 @@
+package notify
+
+import (
+	"context"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// KubeEvent creates a core/v1.Event on the pod's involvedObject with
+// reason OOMKilledDetected, so `kubectl describe pod` shows the enriched
+// context alongside kubelet's own events.
+type KubeEvent struct {
+	Clientset *kubernetes.Clientset
+}
+
+// Name implements Notifier.
+func (k *KubeEvent) Name() string { return "kube-event" }
+
+// Notify implements Notifier. It is a no-op for error reports, since those
+// have no pod to attach an Event to.
+func (k *KubeEvent) Notify(ctx context.Context, report OOMReport) error {
+	if report.Err != nil {
+		return nil
+	}
+
+	now := metav1.NewTime(report.Time)
+
+	event := &v1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "oom-killed-detected-",
+			Namespace:    report.Namespace,
+		},
+		InvolvedObject: v1.ObjectReference{
+			Kind:      "Pod",
+			Namespace: report.Namespace,
+			Name:      report.Pod,
+			FieldPath: fieldPath(report.Container),
+		},
+		Reason:         "OOMKilledDetected",
+		Message:        report.Message(),
+		Source:         v1.EventSource{Component: "kube-oom-watcher"},
+		FirstTimestamp: now,
+		LastTimestamp:  now,
+		Count:          1,
+		Type:           v1.EventTypeWarning,
+	}
+
+	_, err := k.Clientset.CoreV1().Events(report.Namespace).Create(ctx, event, metav1.CreateOptions{})
+	return err
+}
+
+func fieldPath(container string) string {
+	if container == "" {
+		return ""
+	}
+
+	return "spec.containers{" + container + "}"
+}
@@ -0,0 +1,141 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/smpio/kube-oom-watcher/pkg/metrics"
+)
+
+// Manager fans a report out to every configured Notifier, retrying each
+// with exponential backoff and suppressing duplicate OOM alerts for the
+// same (namespace, pod, container) within Window.
+type Manager struct {
+	Notifiers  []Notifier
+	Window     time.Duration
+	MaxRetries int
+
+	mu   sync.Mutex
+	seen map[string]time.Time
+}
+
+// NewManager builds a Manager. window of zero disables deduplication.
+func NewManager(notifiers []Notifier, window time.Duration) *Manager {
+	return &Manager{
+		Notifiers:  notifiers,
+		Window:     window,
+		MaxRetries: 3,
+		seen:       make(map[string]time.Time),
+	}
+}
+
+// Notify fans report out to all notifiers. It returns the last error
+// encountered, if any, but always attempts every notifier regardless of
+// earlier failures.
+func (m *Manager) Notify(ctx context.Context, report OOMReport) error {
+	return m.notify(ctx, report, nil)
+}
+
+// NotifyNames behaves like Notify, but only fans out to notifiers whose
+// Name is in names. An empty names fans out to every configured notifier,
+// matching OOMAlertPolicySpec.Notifiers' "empty means all" semantics.
+func (m *Manager) NotifyNames(ctx context.Context, report OOMReport, names []string) error {
+	return m.notify(ctx, report, names)
+}
+
+func (m *Manager) notify(ctx context.Context, report OOMReport, names []string) error {
+	if report.Err == nil && m.suppressed(report) {
+		return nil
+	}
+
+	var allow map[string]bool
+	if len(names) > 0 {
+		allow = make(map[string]bool, len(names))
+		for _, n := range names {
+			allow[n] = true
+		}
+	}
+
+	var lastErr error
+	sent := false
+	for _, n := range m.Notifiers {
+		if allow != nil && !allow[n.Name()] {
+			continue
+		}
+
+		if err := m.sendWithRetry(ctx, n, report); err != nil {
+			log.Println("notify:", err)
+			lastErr = err
+		} else {
+			sent = true
+		}
+	}
+
+	// Only mark this report as delivered once something actually got
+	// through; if every notifier failed, a real kill shouldn't be
+	// silently swallowed by the dedup window next time it recurs.
+	if report.Err == nil && sent {
+		m.markSeen(report)
+	}
+
+	return lastErr
+}
+
+func (m *Manager) suppressed(report OOMReport) bool {
+	if m.Window <= 0 {
+		return false
+	}
+
+	key := report.Key()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	last, ok := m.seen[key]
+	return ok && time.Since(last) < m.Window
+}
+
+func (m *Manager) markSeen(report OOMReport) {
+	if m.Window <= 0 {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.seen[report.Key()] = time.Now()
+}
+
+func (m *Manager) sendWithRetry(ctx context.Context, n Notifier, report OOMReport) error {
+	backend := fmt.Sprintf("%T", n)
+	backoff := time.Second
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		start := time.Now()
+		err = n.Notify(ctx, report)
+		status := "ok"
+		if err != nil {
+			status = "error"
+		}
+		metrics.NotifierSendDuration.WithLabelValues(backend, status).Observe(time.Since(start).Seconds())
+
+		if err == nil {
+			return nil
+		}
+
+		if attempt >= m.MaxRetries {
+			return err
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+
+		backoff *= 2
+	}
+}
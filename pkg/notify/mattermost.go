@@ -0,0 +1,23 @@
+package notify
+
+import (
+	"context"
+)
+
+// Mattermost posts to a Mattermost incoming webhook. The payload shape is
+// the same one the watcher's original single webhook used, so it also
+// works unmodified against a Mattermost instance.
+type Mattermost struct {
+	WebhookURL string
+}
+
+// Name implements Notifier.
+func (m *Mattermost) Name() string { return "mattermost" }
+
+// Notify implements Notifier.
+func (m *Mattermost) Notify(ctx context.Context, report OOMReport) error {
+	return postJSON(ctx, m.WebhookURL, map[string]string{
+		"username": "OOM watcher",
+		"text":     report.Message(),
+	})
+}
@@ -0,0 +1,62 @@
+// Package notify delivers OOM reports to one or more configured
+// destinations (Slack, Mattermost, PagerDuty, MS Teams, generic webhooks,
+// or a Kubernetes Event), replacing the single hardcoded webhook the
+// watcher used to post to.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// OOMReport describes a detected OOM kill ready to be formatted and
+// delivered by a Notifier. When Err is set instead, it describes a
+// watcher-internal error rather than an actual OOM kill.
+type OOMReport struct {
+	Namespace    string
+	Pod          string
+	Container    string
+	Node         string
+	PID          uint64
+	NSPID        uint64
+	WorkloadKind string
+	WorkloadName string
+	Time         time.Time
+
+	// Labels are the pod's own Kubernetes labels, used by CRDSink to
+	// populate the OOMEvent it creates so pkg/controller can match
+	// OOMAlertPolicy label selectors against them.
+	Labels map[string]string
+
+	Err error
+}
+
+// Key identifies the pod/container a report is about, used for
+// deduplication and rate limiting.
+func (r OOMReport) Key() string {
+	return fmt.Sprintf("%s/%s/%s", r.Namespace, r.Pod, r.Container)
+}
+
+// Message renders a human-readable summary, shared by the text-based
+// backends (Slack, Mattermost, generic webhook, ...).
+func (r OOMReport) Message() string {
+	if r.Err != nil {
+		return fmt.Sprintf("Error: %s", r.Err)
+	}
+
+	if r.WorkloadKind != "" {
+		return fmt.Sprintf("OOM in %s %s / container %s (pod %s/%s, node: %s, PID: %d, NSPID: %d)",
+			r.WorkloadKind, r.WorkloadName, r.Container, r.Namespace, r.Pod, r.Node, r.PID, r.NSPID)
+	}
+
+	return fmt.Sprintf("OOM in pod %s/%s (node: %s, PID: %d, NSPID: %d)", r.Namespace, r.Pod, r.Node, r.PID, r.NSPID)
+}
+
+// Notifier delivers an OOMReport to some destination.
+type Notifier interface {
+	// Name identifies this backend for OOMAlertPolicySpec.Notifiers
+	// filtering, e.g. "slack", "pagerduty".
+	Name() string
+	Notify(ctx context.Context, report OOMReport) error
+}
@@ -0,0 +1,29 @@
+package notify
+
+import (
+	"context"
+)
+
+const pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDuty triggers an incident via the PagerDuty Events API v2.
+type PagerDuty struct {
+	RoutingKey string
+}
+
+// Name implements Notifier.
+func (p *PagerDuty) Name() string { return "pagerduty" }
+
+// Notify implements Notifier.
+func (p *PagerDuty) Notify(ctx context.Context, report OOMReport) error {
+	return postJSON(ctx, pagerDutyEventsURL, map[string]interface{}{
+		"routing_key":  p.RoutingKey,
+		"event_action": "trigger",
+		"dedup_key":    report.Key(),
+		"payload": map[string]string{
+			"summary":  report.Message(),
+			"source":   report.Node,
+			"severity": "critical",
+		},
+	})
+}
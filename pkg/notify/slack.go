@@ -0,0 +1,28 @@
+package notify
+
+import (
+	"context"
+)
+
+// Slack posts a Block Kit message to an incoming webhook URL.
+type Slack struct {
+	WebhookURL string
+}
+
+// Name implements Notifier.
+func (s *Slack) Name() string { return "slack" }
+
+// Notify implements Notifier.
+func (s *Slack) Notify(ctx context.Context, report OOMReport) error {
+	return postJSON(ctx, s.WebhookURL, map[string]interface{}{
+		"blocks": []map[string]interface{}{
+			{
+				"type": "section",
+				"text": map[string]string{
+					"type": "mrkdwn",
+					"text": report.Message(),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,23 @@
+package notify
+
+import (
+	"context"
+)
+
+// Teams posts a MessageCard to an MS Teams incoming webhook connector.
+type Teams struct {
+	WebhookURL string
+}
+
+// Name implements Notifier.
+func (t *Teams) Name() string { return "teams" }
+
+// Notify implements Notifier.
+func (t *Teams) Notify(ctx context.Context, report OOMReport) error {
+	return postJSON(ctx, t.WebhookURL, map[string]interface{}{
+		"@type":    "MessageCard",
+		"@context": "http://schema.org/extensions",
+		"summary":  "OOM watcher",
+		"text":     report.Message(),
+	})
+}
@@ -0,0 +1,51 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Webhook posts a plain JSON payload, same shape the watcher used to post
+// unconditionally before notifier backends became pluggable.
+type Webhook struct {
+	URL string
+}
+
+// Name implements Notifier.
+func (w *Webhook) Name() string { return "webhook" }
+
+// Notify implements Notifier.
+func (w *Webhook) Notify(ctx context.Context, report OOMReport) error {
+	return postJSON(ctx, w.URL, map[string]string{
+		"username": "OOM watcher",
+		"text":     report.Message(),
+	})
+}
+
+func postJSON(ctx context.Context, url string, data interface{}) error {
+	jsonValue, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(jsonValue))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("Failed to POST to %s: %s", url, resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package source
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/smpio/kube-oom-watcher/pkg/metrics"
+)
+
+var pidRegExp = regexp.MustCompile(`Kill\s+process\s+(\d+)`)
+
+// APIServerSource watches the API server for kubelet's "OOMKilling" node
+// events via a SharedInformer, so it gets automatic re-list on 410 Gone
+// and periodic resync for free instead of hand-rolling resourceVersion
+// bookkeeping. It only ever sees legacy cgroup v1 system-OOMs, since
+// that's all kubelet reports.
+type APIServerSource struct {
+	Clientset *kubernetes.Clientset
+
+	// Resync is how often the informer resyncs its store. Defaults to
+	// 10 minutes.
+	Resync time.Duration
+
+	// Health, if set, records a failure on every watch reconnect and a
+	// success once the initial cache sync completes, so /healthz reflects
+	// this source the same way the pod indexer's does.
+	Health *metrics.Health
+}
+
+// Run implements Source. It blocks until ctx is cancelled.
+func (s *APIServerSource) Run(ctx context.Context, c chan<- OOMEvent) error {
+	resync := s.Resync
+	if resync == 0 {
+		resync = 10 * time.Minute
+	}
+
+	factory := informers.NewSharedInformerFactory(s.Clientset, resync)
+	informer := factory.Core().V1().Events().Informer()
+
+	err := informer.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		metrics.EventWatchRestartsTotal.WithLabelValues("apiserver").Inc()
+		if s.Health != nil {
+			s.Health.RecordWatchFailure()
+		}
+		cache.DefaultWatchErrorHandler(r, err)
+	})
+	if err != nil {
+		return fmt.Errorf("setting watch error handler: %w", err)
+	}
+
+	informer.AddEventHandler(cache.ResourceEventHandlerDetailedFuncs{
+		AddFunc: func(obj interface{}, isInInitialList bool) {
+			if isInInitialList {
+				// Don't replay events that already existed before this
+				// process started watching: every (re)start would otherwise
+				// re-fire for OOMKilling events from hours or days ago.
+				return
+			}
+
+			event, ok := obj.(*v1.Event)
+			if !ok {
+				return
+			}
+			s.handle(event, c)
+		},
+	})
+
+	factory.Start(ctx.Done())
+	if !cache.WaitForCacheSync(ctx.Done(), informer.HasSynced) {
+		return fmt.Errorf("APIServerSource: cache never synced")
+	}
+
+	if s.Health != nil {
+		s.Health.RecordWatchSuccess()
+	}
+
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+func (s *APIServerSource) handle(event *v1.Event, c chan<- OOMEvent) {
+	if event.Reason != "OOMKilling" {
+		return
+	}
+
+	if event.InvolvedObject.Kind != "Node" {
+		return
+	}
+
+	node := event.InvolvedObject.Name
+	pid, err := extractPID(event.Message)
+	if err != nil {
+		metrics.HandleErrorsTotal.WithLabelValues("extract_pid").Inc()
+		c <- OOMEvent{Error: err}
+		return
+	}
+
+	c <- OOMEvent{
+		Node: node,
+		PID:  pid,
+	}
+}
+
+func extractPID(message string) (uint64, error) {
+	match := pidRegExp.FindStringSubmatch(message)
+	if match == nil {
+		return 0, fmt.Errorf("Event message does not match: %s", message)
+	}
+
+	pid, err := strconv.ParseUint(match[1], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return pid, nil
+}
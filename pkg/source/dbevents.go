@@ -0,0 +1,98 @@
+package source
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// DBEventSource polls the node_agent_events table that node agents write
+// to via nodeagent.DBForwarder, so events detected on nodes (per-container
+// cgroup v2 OOMs the API server never sees) reach the same handleOOM
+// pipeline as APIServerSource. It creates the table on first use, in case
+// it starts running before any node agent has.
+type DBEventSource struct {
+	DB *sql.DB
+
+	// PollInterval is how often to poll for new rows. Defaults to 5s.
+	PollInterval time.Duration
+
+	// BatchSize is the maximum number of rows consumed per poll. Defaults
+	// to 100.
+	BatchSize int
+}
+
+// Run implements Source. It blocks until ctx is cancelled.
+func (s *DBEventSource) Run(ctx context.Context, c chan<- OOMEvent) error {
+	interval := s.PollInterval
+	if interval == 0 {
+		interval = 5 * time.Second
+	}
+
+	batchSize := s.BatchSize
+	if batchSize == 0 {
+		batchSize = 100
+	}
+
+	_, err := s.DB.ExecContext(ctx, `
+		CREATE TABLE IF NOT EXISTS node_agent_events (
+			id             SERIAL PRIMARY KEY,
+			ts             TIMESTAMPTZ NOT NULL DEFAULT current_timestamp,
+			node           TEXT NOT NULL,
+			pid            BIGINT NOT NULL,
+			container      TEXT,
+			memory_cgroup  TEXT,
+			rss            BIGINT,
+			oom_score_adj  INTEGER
+		)`)
+	if err != nil {
+		return fmt.Errorf("creating node_agent_events table: %w", err)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.poll(ctx, c, batchSize); err != nil {
+			c <- OOMEvent{Error: fmt.Errorf("polling node_agent_events: %w", err)}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (s *DBEventSource) poll(ctx context.Context, c chan<- OOMEvent, batchSize int) error {
+	rows, err := s.DB.QueryContext(ctx, `
+		DELETE FROM node_agent_events
+		WHERE id IN (SELECT id FROM node_agent_events ORDER BY id LIMIT $1)
+		RETURNING node, pid, container, memory_cgroup, rss, oom_score_adj`,
+		batchSize)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var event OOMEvent
+		var container, memoryCgroup sql.NullString
+		var rss, oomScoreAdj sql.NullInt64
+
+		if err := rows.Scan(&event.Node, &event.PID, &container, &memoryCgroup, &rss, &oomScoreAdj); err != nil {
+			return err
+		}
+
+		event.Container = container.String
+		event.MemoryCgroup = memoryCgroup.String
+		event.RSS = uint64(rss.Int64)
+		event.OOMScoreAdj = int(oomScoreAdj.Int64)
+
+		c <- event
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,29 @@
+// Package source defines how OOM kills are discovered and fed into the watcher's
+// handling pipeline, independent of where they come from (API server events,
+// /dev/kmsg, eBPF, ...).
+package source
+
+import "context"
+
+// OOMEvent describes a single detected OOM kill.
+type OOMEvent struct {
+	Node string
+	PID  uint64
+
+	// Container, MemoryCgroup, RSS and OOMScoreAdj are populated by sources
+	// that can resolve them directly (e.g. the node agent). Sources that
+	// only know the node and PID (e.g. the API server source) leave them
+	// zero-valued; handleOOM falls back to the ps-records table for those.
+	Container    string
+	MemoryCgroup string
+	RSS          uint64
+	OOMScoreAdj  int
+
+	Error error
+}
+
+// Source produces OOMEvents from some underlying signal. Run blocks, sending
+// events to c, until ctx is cancelled or an unrecoverable error occurs.
+type Source interface {
+	Run(ctx context.Context, c chan<- OOMEvent) error
+}
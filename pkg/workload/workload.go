@@ -0,0 +1,133 @@
+// Package workload resolves a pod's top-level owning workload (Deployment,
+// StatefulSet, DaemonSet, Job, or CronJob) by walking its ownerReferences.
+package workload
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+)
+
+// cacheTTL bounds how long a ReplicaSet -> Deployment or Job -> CronJob
+// resolution is cached, keyed by the ReplicaSet/Job's UID. Full resolution
+// runs from the pod informer's event handler, which is called on every pod
+// Add and Update; without caching it would re-fetch the same owner from
+// the API server on every single update of a churny ReplicaSet-owned pod.
+const cacheTTL = 5 * time.Minute
+
+type cacheEntry struct {
+	kind    string
+	name    string
+	expires time.Time
+}
+
+var (
+	cacheMu sync.Mutex
+	cache   = make(map[types.UID]cacheEntry)
+)
+
+func cacheGet(uid types.UID) (kind, name string, ok bool) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	entry, found := cache[uid]
+	if !found || time.Now().After(entry.expires) {
+		return "", "", false
+	}
+
+	return entry.kind, entry.name, true
+}
+
+func cacheSet(uid types.UID, kind, name string) {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	cache[uid] = cacheEntry{kind: kind, name: name, expires: time.Now().Add(cacheTTL)}
+}
+
+// Resolution controls how far Resolve walks a pod's ownership chain.
+type Resolution string
+
+const (
+	// None skips resolution entirely.
+	None Resolution = "none"
+	// Direct reports the pod's immediate owner (e.g. "ReplicaSet").
+	Direct Resolution = "direct"
+	// Full additionally walks ReplicaSet -> Deployment and Job -> CronJob,
+	// so a pod from a Deployment reports "Deployment", not "ReplicaSet".
+	Full Resolution = "full"
+)
+
+// Resolve returns the kind and name of pod's owning workload, according to
+// resolution. It returns ("", "") if the pod has no recognized owner, or
+// if resolution is None.
+func Resolve(ctx context.Context, clientset *kubernetes.Clientset, namespace string, ownerRefs []metav1.OwnerReference, resolution Resolution) (kind, name string, err error) {
+	if resolution == None {
+		return "", "", nil
+	}
+
+	owner, ok := controllerOf(ownerRefs)
+	if !ok {
+		return "", "", nil
+	}
+
+	if resolution == Direct {
+		return owner.Kind, owner.Name, nil
+	}
+
+	switch owner.Kind {
+	case "ReplicaSet":
+		if kind, name, ok := cacheGet(owner.UID); ok {
+			return kind, name, nil
+		}
+
+		rs, err := clientset.AppsV1().ReplicaSets(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return owner.Kind, owner.Name, fmt.Errorf("resolving owner of ReplicaSet %s/%s: %w", namespace, owner.Name, err)
+		}
+
+		kind, name := owner.Kind, owner.Name
+		if deploy, ok := controllerOf(rs.OwnerReferences); ok && deploy.Kind == "Deployment" {
+			kind, name = deploy.Kind, deploy.Name
+		}
+
+		cacheSet(owner.UID, kind, name)
+		return kind, name, nil
+
+	case "Job":
+		if kind, name, ok := cacheGet(owner.UID); ok {
+			return kind, name, nil
+		}
+
+		job, err := clientset.BatchV1().Jobs(namespace).Get(ctx, owner.Name, metav1.GetOptions{})
+		if err != nil {
+			return owner.Kind, owner.Name, fmt.Errorf("resolving owner of Job %s/%s: %w", namespace, owner.Name, err)
+		}
+
+		kind, name := owner.Kind, owner.Name
+		if cronJob, ok := controllerOf(job.OwnerReferences); ok && cronJob.Kind == "CronJob" {
+			kind, name = cronJob.Kind, cronJob.Name
+		}
+
+		cacheSet(owner.UID, kind, name)
+		return kind, name, nil
+
+	default:
+		// StatefulSet, DaemonSet, and anything else already is top-level.
+		return owner.Kind, owner.Name, nil
+	}
+}
+
+func controllerOf(refs []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	for _, ref := range refs {
+		if ref.Controller != nil && *ref.Controller {
+			return ref, true
+		}
+	}
+	return metav1.OwnerReference{}, false
+}